@@ -0,0 +1,21 @@
+// Command go-logger-pretty pipes a JSON or logfmt log stream from
+// stdin through scanner.Scan and writes the colored, human-friendly
+// rendering to stdout, e.g. `myservice | go-logger-pretty`.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/mattn/go-colorable"
+
+	"github.com/KOFI-GYIMAH/go-logger/logger/scanner"
+)
+
+func main() {
+	out := colorable.NewColorableStdout()
+	if err := scanner.Scan(os.Stdin, out, scanner.ScanOptions{}); err != nil {
+		fmt.Fprintln(os.Stderr, "go-logger-pretty:", err)
+		os.Exit(1)
+	}
+}
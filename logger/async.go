@@ -0,0 +1,198 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// DropPolicy controls how Log behaves once an async logger's buffer is
+// full.
+type DropPolicy int
+
+const (
+	// Block makes Log wait for room in the buffer, same as synchronous
+	// logging.
+	Block DropPolicy = iota
+	// DropOldest discards the oldest buffered record to make room for
+	// the new one.
+	DropOldest
+	// DropNewest discards the record currently being logged, leaving
+	// the buffer untouched.
+	DropNewest
+)
+
+// Stats reports counters tracked by the logger's async worker and
+// sampler, if either is enabled.
+type Stats struct {
+	// Dropped counts records discarded by the async buffer's drop
+	// policy.
+	Dropped uint64
+	// DroppedBySampler counts records Sample rejected before they were
+	// ever formatted.
+	DroppedBySampler uint64
+}
+
+type asyncRecord struct {
+	line string
+	ack  chan struct{}
+}
+
+type asyncState struct {
+	records chan asyncRecord
+	policy  DropPolicy
+	dropped atomic.Uint64
+	wg      sync.WaitGroup
+
+	// sendMu guards the open/closed state of records. Senders hold it
+	// for read (so they can run concurrently); Close takes it for write
+	// so it can mark the state stopped and close the channel with the
+	// guarantee that no send is in flight.
+	sendMu  sync.RWMutex
+	stopped bool
+}
+
+// EnableAsync switches the logger to non-blocking mode: Log formats
+// the record on the caller's goroutine but hands it off to a
+// bufferSize-deep channel instead of writing it directly, and a single
+// background goroutine owns all writes to the logger's output. policy
+// decides what happens once that channel fills up. It is a no-op if
+// async mode is already enabled.
+func (l *Logger) EnableAsync(bufferSize int, policy DropPolicy) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.async != nil {
+		return
+	}
+
+	a := &asyncState{
+		records: make(chan asyncRecord, bufferSize),
+		policy:  policy,
+	}
+	l.async = a
+
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		for rec := range a.records {
+			if rec.ack != nil {
+				close(rec.ack)
+				continue
+			}
+			fmt.Fprintln(l.out, rec.line)
+		}
+	}()
+}
+
+// Stats returns the current drop counters for the async buffer (zero
+// if async mode is not enabled) and the sampler (zero if no sampler is
+// set).
+func (l *Logger) Stats() Stats {
+	l.mutex.Lock()
+	a := l.async
+	l.mutex.Unlock()
+
+	stats := Stats{DroppedBySampler: l.samplerDropped.Load()}
+	if a != nil {
+		stats.Dropped = a.dropped.Load()
+	}
+	return stats
+}
+
+// Flush blocks until every record already queued at the time of the
+// call has been written, or ctx is done. It is a no-op if async mode
+// is not enabled.
+func (l *Logger) Flush(ctx context.Context) error {
+	l.mutex.Lock()
+	a := l.async
+	l.mutex.Unlock()
+
+	if a == nil {
+		return nil
+	}
+
+	a.sendMu.RLock()
+	if a.stopped {
+		a.sendMu.RUnlock()
+		return nil
+	}
+
+	ack := make(chan struct{})
+	select {
+	case a.records <- asyncRecord{ack: ack}:
+	case <-ctx.Done():
+		a.sendMu.RUnlock()
+		return ctx.Err()
+	}
+	a.sendMu.RUnlock()
+
+	select {
+	case <-ack:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close disables async mode, so Log calls made from then on write
+// synchronously again, and then drains and stops the async worker. It
+// is a no-op if async mode is not enabled.
+//
+// It takes sendMu for write before closing records, which blocks until
+// every writeAsync/Flush call already in flight has finished sending,
+// so the channel is never closed out from under a concurrent send.
+func (l *Logger) Close() error {
+	l.mutex.Lock()
+	a := l.async
+	l.async = nil
+	l.mutex.Unlock()
+
+	if a == nil {
+		return nil
+	}
+
+	a.sendMu.Lock()
+	a.stopped = true
+	close(a.records)
+	a.sendMu.Unlock()
+
+	a.wg.Wait()
+	return nil
+}
+
+func (l *Logger) writeAsync(a *asyncState, line string) {
+	a.sendMu.RLock()
+	defer a.sendMu.RUnlock()
+
+	if a.stopped {
+		return
+	}
+
+	rec := asyncRecord{line: line}
+
+	select {
+	case a.records <- rec:
+		return
+	default:
+	}
+
+	switch a.policy {
+	case Block:
+		a.records <- rec
+	case DropNewest:
+		a.dropped.Add(1)
+	case DropOldest:
+		select {
+		case <-a.records:
+			a.dropped.Add(1)
+		default:
+		}
+		select {
+		case a.records <- rec:
+		default:
+			a.dropped.Add(1)
+		}
+	}
+}
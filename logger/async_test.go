@@ -0,0 +1,157 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAsyncLogging(t *testing.T) {
+	buf := &bytes.Buffer{}
+	var mu sync.Mutex
+	syncBuf := &syncWriter{buf: buf, mu: &mu}
+
+	logger := NewLogger("async")
+	logger.SetOutput(syncBuf)
+	logger.SetColor(false)
+	logger.EnableAsync(16, Block)
+	defer logger.Close()
+
+	for i := range 10 {
+		logger.Info(fmt.Sprintf("message %d", i), LogFields{})
+	}
+
+	if err := logger.Flush(context.Background()); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+
+	mu.Lock()
+	out := buf.String()
+	mu.Unlock()
+
+	if strings.Count(out, "message") != 10 {
+		t.Errorf("expected 10 flushed messages, got: %s", out)
+	}
+}
+
+func TestAsyncDropPolicy(t *testing.T) {
+	// The worker blocks on its first write until the test ends, so the
+	// one-slot buffer is guaranteed to fill.
+	out := &blockingWriter{release: make(chan struct{})}
+	logger := NewLogger("async-drop")
+	logger.SetOutput(out)
+	logger.SetColor(false)
+	logger.EnableAsync(1, DropNewest)
+	defer logger.Close()
+	defer close(out.release)
+
+	for range 100 {
+		logger.Info("flood", LogFields{})
+	}
+
+	if logger.Stats().Dropped == 0 {
+		t.Error("expected some records to be dropped under DropNewest with a tiny buffer")
+	}
+}
+
+func TestAsyncDropOldestCountsEvictions(t *testing.T) {
+	out := &blockingWriter{release: make(chan struct{})}
+	logger := NewLogger("async-drop-oldest")
+	logger.SetOutput(out)
+	logger.SetColor(false)
+	logger.EnableAsync(1, DropOldest)
+	defer logger.Close()
+	defer close(out.release)
+
+	for range 100 {
+		logger.Info("flood", LogFields{})
+	}
+
+	if logger.Stats().Dropped == 0 {
+		t.Error("expected DropOldest evictions to be counted in Stats().Dropped")
+	}
+}
+
+func TestAsyncCloseWhileLogging(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		logger := NewLogger("async-close")
+		logger.SetOutput(io.Discard)
+		logger.SetColor(false)
+		logger.EnableAsync(1, Block)
+
+		stop := make(chan struct{})
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+					logger.Info("racing with Close", LogFields{})
+				}
+			}
+		}()
+
+		time.Sleep(5 * time.Millisecond)
+		if err := logger.Close(); err != nil {
+			t.Fatalf("close failed: %v", err)
+		}
+		close(stop)
+		<-done
+	}
+}
+
+// syncWriter guards a bytes.Buffer so the async worker goroutine and
+// the test goroutine can safely race on reads/writes.
+type syncWriter struct {
+	buf *bytes.Buffer
+	mu  *sync.Mutex
+}
+
+func (w *syncWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.buf.Write(p)
+}
+
+// blockingWriter holds every Write until release is closed, stalling
+// the async worker so its buffer fills up.
+type blockingWriter struct {
+	release chan struct{}
+}
+
+func (w *blockingWriter) Write(p []byte) (int, error) {
+	<-w.release
+	return len(p), nil
+}
+
+func BenchmarkLoggerSync(b *testing.B) {
+	logger := NewLogger("bench-sync")
+	logger.SetOutput(io.Discard)
+	logger.SetColor(false)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		logger.Info("benchmark message", LogFields{})
+	}
+}
+
+func BenchmarkLoggerAsync(b *testing.B) {
+	logger := NewLogger("bench-async")
+	logger.SetOutput(io.Discard)
+	logger.SetColor(false)
+	logger.EnableAsync(1024, DropOldest)
+	defer logger.Close()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		logger.Info("benchmark message", LogFields{})
+	}
+	logger.Flush(context.Background())
+}
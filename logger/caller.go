@@ -0,0 +1,51 @@
+package logger
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+// loggerPackagePrefix identifies stack frames that belong to this
+// package itself (Log, Entry.log, LogCtx, ...), which captureCaller
+// always walks past regardless of the configured skip.
+const loggerPackagePrefix = "github.com/KOFI-GYIMAH/go-logger/logger."
+
+// captureCaller walks the stack starting at its own caller, skipping
+// any frames still inside the logger package plus the logger's
+// SetCallerSkip value and extraSkip further frames, and returns the
+// first remaining frame as "file:line". This
+// lets the logger auto-detect the real caller even when wrapped by
+// Entry chaining, LogCtx, or other logger-internal indirection.
+func (l *Logger) captureCaller(extraSkip int) string {
+	const maxDepth = 64
+	pcs := make([]uintptr, maxDepth)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	l.mutex.Lock()
+	skip := l.callerSkip + extraSkip
+	l.mutex.Unlock()
+
+	skipped := 0
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, loggerPackagePrefix) {
+			if skipped >= skip {
+				return formatCallerFrame(frame.File, frame.Line)
+			}
+			skipped++
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+func formatCallerFrame(file string, line int) string {
+	fileParts := strings.Split(file, "/")
+	if len(fileParts) > 3 {
+		fileParts = fileParts[len(fileParts)-3:]
+	}
+	return fmt.Sprintf("%s:%d", strings.Join(fileParts, "/"), line)
+}
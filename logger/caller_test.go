@@ -0,0 +1,93 @@
+package logger_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/KOFI-GYIMAH/go-logger/logger"
+)
+
+func wrapLog(l *logger.Logger, msg string) {
+	l.Info(msg, logger.LogFields{})
+}
+
+func callerInfoOf(t *testing.T, buf *bytes.Buffer) string {
+	t.Helper()
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("failed to parse JSON log: %v", err)
+	}
+	fields, ok := entry["fields"].(map[string]any)
+	if !ok {
+		t.Fatal("JSON fields not found or not a map")
+	}
+	caller, _ := fields["caller_info"].(string)
+	return caller
+}
+
+func TestCallerSkip(t *testing.T) {
+	buf := &bytes.Buffer{}
+	l := logger.NewLogger("caller")
+	l.SetOutput(buf)
+	l.SetColor(false)
+	l.SetFormatter(logger.JSONFormatter)
+
+	wrapLog(l, "from wrapper")
+	withoutSkip := callerInfoOf(t, buf)
+
+	buf.Reset()
+	l.SetCallerSkip(1)
+	wrapLog(l, "from wrapper, skipped")
+	withSkip := callerInfoOf(t, buf)
+
+	if withoutSkip == "" || withSkip == "" {
+		t.Fatalf("expected caller info to be populated, got %q and %q", withoutSkip, withSkip)
+	}
+	if withoutSkip == withSkip {
+		t.Errorf("expected SetCallerSkip to change the reported caller, both were %q", withoutSkip)
+	}
+	if !strings.Contains(withoutSkip, "caller_test.go") || !strings.Contains(withSkip, "caller_test.go") {
+		t.Errorf("expected both caller_info values to point into caller_test.go, got %q and %q", withoutSkip, withSkip)
+	}
+}
+
+func TestReportCallerDisabled(t *testing.T) {
+	buf := &bytes.Buffer{}
+	l := logger.NewLogger("no-caller")
+	l.SetOutput(buf)
+	l.SetColor(false)
+	l.SetFormatter(logger.JSONFormatter)
+	l.SetReportCaller(false)
+
+	l.Info("no caller info", logger.LogFields{})
+
+	if caller := callerInfoOf(t, buf); caller != "" {
+		t.Errorf("expected no caller info when report caller is disabled, got %q", caller)
+	}
+}
+
+func BenchmarkLogWithCaller(b *testing.B) {
+	l := logger.NewLogger("bench-caller")
+	l.SetOutput(io.Discard)
+	l.SetColor(false)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l.Info("benchmark message", logger.LogFields{})
+	}
+}
+
+func BenchmarkLogWithoutCaller(b *testing.B) {
+	l := logger.NewLogger("bench-no-caller")
+	l.SetOutput(io.Discard)
+	l.SetColor(false)
+	l.SetReportCaller(false)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l.Info("benchmark message", logger.LogFields{})
+	}
+}
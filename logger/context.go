@@ -0,0 +1,105 @@
+package logger
+
+import "context"
+
+// ContextExtractor pulls structured fields out of a context.Context to
+// be merged into a record logged via LogCtx.
+type ContextExtractor func(ctx context.Context) map[string]any
+
+// RegisterContextKey tells LogCtx to look up key in the context under
+// name and, if present, include it as a structured field. It is a thin
+// convenience over RegisterContextExtractor for the common case of a
+// single context value.
+func (l *Logger) RegisterContextKey(name string, key any) {
+	l.RegisterContextExtractor(func(ctx context.Context) map[string]any {
+		v := ctx.Value(key)
+		if v == nil {
+			return nil
+		}
+		return map[string]any{name: v}
+	})
+}
+
+// RegisterContextExtractor adds an extractor run by every LogCtx call.
+// Extractors run in registration order; later extractors win on key
+// collisions.
+func (l *Logger) RegisterContextExtractor(extractor ContextExtractor) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.contextExtractors = append(l.contextExtractors, extractor)
+}
+
+// LogCtx runs every registered context extractor against ctx, merges
+// their fields into fields.Extra, and logs the result.
+func (l *Logger) LogCtx(ctx context.Context, level LogLevel, msg string, fields LogFields) {
+	l.mutex.Lock()
+	extractors := l.contextExtractors
+	l.mutex.Unlock()
+
+	if len(extractors) > 0 {
+		merged := make(map[string]any, len(fields.Extra))
+		for k, v := range fields.Extra {
+			merged[k] = v
+		}
+		for _, extract := range extractors {
+			for k, v := range extract(ctx) {
+				merged[k] = v
+			}
+		}
+		fields.Extra = merged
+	}
+
+	l.Log(level, msg, fields)
+}
+
+// Built-in context keys used by the RequestID/TraceID/SpanID/UserID
+// extractors below. Callers that store these values under their own
+// key should use RegisterContextKey directly instead.
+type contextKey string
+
+const (
+	contextKeyRequestID contextKey = "request_id"
+	contextKeyTraceID   contextKey = "trace_id"
+	contextKeySpanID    contextKey = "span_id"
+	contextKeyUserID    contextKey = "user_id"
+)
+
+func valueExtractor(field string, key any) ContextExtractor {
+	return func(ctx context.Context) map[string]any {
+		v := ctx.Value(key)
+		if v == nil {
+			return nil
+		}
+		return map[string]any{field: v}
+	}
+}
+
+// RequestIDExtractor reads a request ID stored under contextKeyRequestID.
+var RequestIDExtractor = valueExtractor("request_id", contextKeyRequestID)
+
+// TraceIDExtractor reads a trace ID stored under contextKeyTraceID.
+var TraceIDExtractor = valueExtractor("trace_id", contextKeyTraceID)
+
+// SpanIDExtractor reads a span ID stored under contextKeySpanID.
+var SpanIDExtractor = valueExtractor("span_id", contextKeySpanID)
+
+// UserIDExtractor reads a user ID stored under contextKeyUserID.
+var UserIDExtractor = valueExtractor("user_id", contextKeyUserID)
+
+// NewOTelExtractor builds a ContextExtractor for trace/span IDs without
+// this package depending on go.opentelemetry.io/otel directly. Pass
+// trace.SpanContextFromContext (adapted to this signature) as spanContext:
+//
+//	logger.NewOTelExtractor(func(ctx context.Context) (traceID, spanID string, ok bool) {
+//		sc := trace.SpanContextFromContext(ctx)
+//		return sc.TraceID().String(), sc.SpanID().String(), sc.IsValid()
+//	})
+func NewOTelExtractor(spanContext func(ctx context.Context) (traceID, spanID string, ok bool)) ContextExtractor {
+	return func(ctx context.Context) map[string]any {
+		traceID, spanID, ok := spanContext(ctx)
+		if !ok {
+			return nil
+		}
+		return map[string]any{"trace_id": traceID, "span_id": spanID}
+	}
+}
@@ -0,0 +1,64 @@
+package logger
+
+import (
+	"os"
+	"time"
+)
+
+// Entry accumulates structured fields (and an optional timestamp
+// override) before they are written through its Logger. It is created
+// via Logger.WithField / Logger.WithFields and is safe to extend with
+// further chained WithField/WithFields/WithTime calls.
+type Entry struct {
+	Logger *Logger
+	Fields map[string]any
+	Time   time.Time
+}
+
+// WithField starts an Entry carrying a single structured field.
+func (l *Logger) WithField(key string, value any) *Entry {
+	return (&Entry{Logger: l}).WithField(key, value)
+}
+
+// WithFields starts an Entry carrying the given structured fields.
+func (l *Logger) WithFields(fields map[string]any) *Entry {
+	return (&Entry{Logger: l}).WithFields(fields)
+}
+
+// WithField returns a new Entry with key/value merged in, leaving the
+// receiver untouched.
+func (e *Entry) WithField(key string, value any) *Entry {
+	return e.WithFields(map[string]any{key: value})
+}
+
+// WithFields returns a new Entry with fields merged in, leaving the
+// receiver untouched.
+func (e *Entry) WithFields(fields map[string]any) *Entry {
+	merged := make(map[string]any, len(e.Fields)+len(fields))
+	for k, v := range e.Fields {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+	return &Entry{Logger: e.Logger, Fields: merged, Time: e.Time}
+}
+
+// WithTime returns a new Entry whose record is stamped with t instead
+// of time.Now().
+func (e *Entry) WithTime(t time.Time) *Entry {
+	return &Entry{Logger: e.Logger, Fields: e.Fields, Time: t}
+}
+
+func (e *Entry) log(level LogLevel, msg string) {
+	e.Logger.Log(level, msg, LogFields{Extra: e.Fields, Time: e.Time})
+}
+
+func (e *Entry) Debug(msg string) { e.log(LevelDebug, msg) }
+func (e *Entry) Info(msg string)  { e.log(LevelInfo, msg) }
+func (e *Entry) Warn(msg string)  { e.log(LevelWarn, msg) }
+func (e *Entry) Error(msg string) { e.log(LevelError, msg) }
+func (e *Entry) Fatal(msg string) {
+	e.log(LevelFatal, msg)
+	os.Exit(1)
+}
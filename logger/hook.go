@@ -0,0 +1,69 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// Hook lets a Logger fan log records out to external sinks (files,
+// syslog, Sentry, HTTP endpoints) without replacing its formatter.
+// Levels reports which levels the hook wants to see; Fire is called
+// once per matching record after it has been written to the logger's
+// own output or, in async mode, handed to the async buffer (hooks fire
+// even when the buffer's drop policy discards the record).
+type Hook interface {
+	Levels() []LogLevel
+	Fire(level LogLevel, msg string, fields LogFields, ts time.Time) error
+}
+
+// AddHook registers a hook. Hooks fire in registration order.
+func (l *Logger) AddHook(hook Hook) {
+	l.hooksMutex.Lock()
+	defer l.hooksMutex.Unlock()
+	l.hooks = append(l.hooks, hook)
+}
+
+// ClearHooks removes all registered hooks.
+func (l *Logger) ClearHooks() {
+	l.hooksMutex.Lock()
+	defer l.hooksMutex.Unlock()
+	l.hooks = nil
+}
+
+// SetErrorHandler overrides how errors returned by hooks are reported.
+// The default handler writes them to stderr. It shares hooksMutex with
+// AddHook/ClearHooks/fireHooks since errorHandler is only ever read
+// alongside the hook list.
+func (l *Logger) SetErrorHandler(fn func(error)) {
+	l.hooksMutex.Lock()
+	defer l.hooksMutex.Unlock()
+	l.errorHandler = fn
+}
+
+func (l *Logger) fireHooks(level LogLevel, msg string, fields LogFields, ts time.Time) {
+	l.hooksMutex.RLock()
+	defer l.hooksMutex.RUnlock()
+
+	for _, hook := range l.hooks {
+		if !levelMatches(hook.Levels(), level) {
+			continue
+		}
+		if err := hook.Fire(level, msg, fields, ts); err != nil {
+			l.errorHandler(err)
+		}
+	}
+}
+
+func levelMatches(levels []LogLevel, level LogLevel) bool {
+	for _, l := range levels {
+		if l == level {
+			return true
+		}
+	}
+	return false
+}
+
+func defaultErrorHandler(err error) {
+	fmt.Fprintln(os.Stderr, "logger: hook error:", err)
+}
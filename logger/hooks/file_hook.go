@@ -0,0 +1,102 @@
+// Package hooks provides built-in logger.Hook implementations that
+// demonstrate the hook API: FileHook writes to a rotating file and
+// WriterHook wraps an arbitrary io.Writer.
+package hooks
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/KOFI-GYIMAH/go-logger/logger"
+)
+
+// HookFormatter renders a log record into a single line for a hook to
+// write out. It mirrors the shape of logger's own formatter functions.
+type HookFormatter func(level logger.LogLevel, msg string, fields logger.LogFields) string
+
+func defaultHookFormatter(level logger.LogLevel, msg string, fields logger.LogFields) string {
+	return fmt.Sprintf("[%s] %s", level.String(), msg)
+}
+
+// FileHook appends formatted log records to a file on disk, rotating
+// it to a timestamped backup before a write that would take it past
+// MaxSizeBytes.
+type FileHook struct {
+	Path         string
+	MaxSizeBytes int64
+	Formatter    HookFormatter
+	LevelsToFire []logger.LogLevel
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+// NewFileHook opens (creating if needed) path and returns a hook that
+// appends formatted records to it for the given levels. A
+// maxSizeBytes of 0 disables rotation.
+func NewFileHook(path string, maxSizeBytes int64, levels []logger.LogLevel) (*FileHook, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &FileHook{
+		Path:         path,
+		MaxSizeBytes: maxSizeBytes,
+		Formatter:    defaultHookFormatter,
+		LevelsToFire: levels,
+		file:         f,
+		size:         info.Size(),
+	}, nil
+}
+
+func (h *FileHook) Levels() []logger.LogLevel {
+	return h.LevelsToFire
+}
+
+func (h *FileHook) Fire(level logger.LogLevel, msg string, fields logger.LogFields, ts time.Time) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	line := h.Formatter(level, msg, fields) + "\n"
+	if h.MaxSizeBytes > 0 && h.size+int64(len(line)) > h.MaxSizeBytes {
+		if err := h.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := h.file.WriteString(line)
+	h.size += int64(n)
+	return err
+}
+
+func (h *FileHook) rotate() error {
+	if err := h.file.Close(); err != nil {
+		return err
+	}
+	backup := fmt.Sprintf("%s.%d", h.Path, time.Now().UnixNano())
+	if err := os.Rename(h.Path, backup); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(h.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	h.file = f
+	h.size = 0
+	return nil
+}
+
+// Close flushes and closes the underlying file.
+func (h *FileHook) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.file.Close()
+}
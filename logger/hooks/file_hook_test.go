@@ -0,0 +1,57 @@
+package hooks
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KOFI-GYIMAH/go-logger/logger"
+)
+
+func TestFileHookRotates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+
+	hook, err := NewFileHook(path, 20, []logger.LogLevel{logger.LevelInfo})
+	if err != nil {
+		t.Fatalf("NewFileHook failed: %v", err)
+	}
+	defer hook.Close()
+
+	if err := hook.Fire(logger.LevelInfo, "first record", logger.LogFields{}, time.Now()); err != nil {
+		t.Fatalf("unexpected error firing hook: %v", err)
+	}
+	// MaxSizeBytes is small enough that this second record pushes the
+	// file over the limit and forces a rotation before it is written.
+	if err := hook.Fire(logger.LevelInfo, "second record after rotation", logger.LogFields{}, time.Now()); err != nil {
+		t.Fatalf("unexpected error firing hook: %v", err)
+	}
+
+	matches, err := filepath.Glob(path + ".*")
+	if err != nil {
+		t.Fatalf("glob failed: %v", err)
+	}
+	if len(matches) != 1 {
+		t.Fatalf("expected exactly one rotated backup file, got %v", matches)
+	}
+
+	backup, err := os.ReadFile(matches[0])
+	if err != nil {
+		t.Fatalf("failed to read backup file: %v", err)
+	}
+	if !strings.Contains(string(backup), "first record") {
+		t.Errorf("expected backup to contain the pre-rotation record, got: %s", backup)
+	}
+
+	live, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read live file: %v", err)
+	}
+	if !strings.Contains(string(live), "second record after rotation") {
+		t.Errorf("expected live file to contain the post-rotation record, got: %s", live)
+	}
+	if strings.Contains(string(live), "first record") {
+		t.Errorf("expected live file to have been reset on rotation, got: %s", live)
+	}
+}
@@ -0,0 +1,41 @@
+package hooks
+
+import (
+	"io"
+	"sync"
+	"time"
+
+	"github.com/KOFI-GYIMAH/go-logger/logger"
+)
+
+// WriterHook fires formatted records at any io.Writer, independent of
+// the logger's own output and formatter. Useful for mirroring logs to
+// a secondary destination.
+type WriterHook struct {
+	Writer       io.Writer
+	Formatter    HookFormatter
+	LevelsToFire []logger.LogLevel
+
+	mu sync.Mutex
+}
+
+// NewWriterHook wraps w, formatting records with formatter (or the
+// default hook formatter if nil) before writing them for the given
+// levels.
+func NewWriterHook(w io.Writer, formatter HookFormatter, levels []logger.LogLevel) *WriterHook {
+	if formatter == nil {
+		formatter = defaultHookFormatter
+	}
+	return &WriterHook{Writer: w, Formatter: formatter, LevelsToFire: levels}
+}
+
+func (h *WriterHook) Levels() []logger.LogLevel {
+	return h.LevelsToFire
+}
+
+func (h *WriterHook) Fire(level logger.LogLevel, msg string, fields logger.LogFields, ts time.Time) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, err := io.WriteString(h.Writer, h.Formatter(level, msg, fields)+"\n")
+	return err
+}
@@ -0,0 +1,24 @@
+package hooks
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KOFI-GYIMAH/go-logger/logger"
+)
+
+func TestWriterHookFire(t *testing.T) {
+	buf := &bytes.Buffer{}
+	hook := NewWriterHook(buf, nil, []logger.LogLevel{logger.LevelError})
+
+	if err := hook.Fire(logger.LevelError, "boom", logger.LogFields{}, time.Now()); err != nil {
+		t.Fatalf("unexpected error firing hook: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "boom") || !strings.Contains(out, "error") {
+		t.Errorf("writer hook did not render expected output: %s", out)
+	}
+}
@@ -1,15 +1,15 @@
 package logger
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"regexp"
-	"runtime"
+	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/fatih/color"
@@ -26,12 +26,24 @@ const (
 )
 
 type Logger struct {
-	name      string
-	level     LogLevel
-	out       io.Writer
-	color     bool
-	formatter func(level LogLevel, message string, fields LogFields) string
-	mutex     sync.Mutex
+	name         string
+	level        LogLevel
+	out          io.Writer
+	color        bool
+	formatter    func(level LogLevel, message string, fields LogFields) string
+	mutex        sync.Mutex
+	hooks        []Hook
+	hooksMutex   sync.RWMutex
+	errorHandler func(error)
+	async        *asyncState
+
+	contextExtractors []ContextExtractor
+
+	sampler        Sampler
+	samplerDropped atomic.Uint64
+
+	callerSkip   int
+	reportCaller bool
 }
 
 type LogFields struct {
@@ -41,17 +53,59 @@ type LogFields struct {
 	Size       string `json:"size,omitempty"`
 	Latency    string `json:"latency,omitempty"`
 	CallerInfo string `json:"caller_info,omitempty"`
+
+	// Time overrides the timestamp a formatter renders; the zero value
+	// means "use time.Now()". Set via Entry.WithTime.
+	Time time.Time `json:"-"`
+	// Extra carries arbitrary structured fields added through
+	// Logger.WithField / Logger.WithFields, merged alongside the fixed
+	// fields above when a formatter renders the record.
+	Extra map[string]any `json:"-"`
+	// CallerSkip adds extra stack frames to skip past for this call
+	// only, on top of the logger's own SetCallerSkip value.
+	CallerSkip int `json:"-"`
+}
+
+// toMap flattens the fixed fields and Extra into a single map so
+// formatters can render both the HTTP-flavored fields and arbitrary
+// structured fields the same way.
+func (f LogFields) toMap() map[string]any {
+	m := make(map[string]any, len(f.Extra)+5)
+	for k, v := range f.Extra {
+		m[k] = v
+	}
+	if f.Method != "" {
+		m["method"] = f.Method
+	}
+	if f.Path != "" {
+		m["path"] = f.Path
+	}
+	if f.Status != "" {
+		m["status"] = f.Status
+	}
+	if f.Size != "" {
+		m["size"] = f.Size
+	}
+	if f.Latency != "" {
+		m["latency"] = f.Latency
+	}
+	if f.CallerInfo != "" {
+		m["caller_info"] = f.CallerInfo
+	}
+	return m
 }
 
 var log = NewLogger("app")
 
 func NewLogger(name string) *Logger {
 	return &Logger{
-		name:      name,
-		level:     LevelInfo,
-		out:       os.Stdout,
-		color:     true,
-		formatter: defaultFormatter,
+		name:         name,
+		level:        LevelInfo,
+		out:          os.Stdout,
+		color:        true,
+		formatter:    defaultFormatter,
+		errorHandler: defaultErrorHandler,
+		reportCaller: true,
 	}
 }
 
@@ -87,18 +141,42 @@ func (l *Logger) SetFormatter(fn func(LogLevel, string, LogFields) string) {
 	l.formatter = fn
 }
 
+// SetCallerSkip adds n extra stack frames to skip past before
+// reporting caller info, useful when the logger is wrapped by helper
+// functions or middleware that would otherwise show up as the caller.
+func (l *Logger) SetCallerSkip(n int) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.callerSkip = n
+}
+
+// SetReportCaller enables or disables caller lookup. Caller lookup is
+// one of the most expensive parts of structured logging; disable it on
+// hot paths that don't need it.
+func (l *Logger) SetReportCaller(enabled bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.reportCaller = enabled
+}
+
 func (l *Logger) Log(level LogLevel, msg string, fields LogFields) {
 	if level < l.level {
 		return
 	}
 
-	if fields.CallerInfo == "" {
-		_, file, line, _ := runtime.Caller(2)
-		fileParts := strings.Split(file, "/")
-		if len(fileParts) > 3 {
-			fileParts = fileParts[len(fileParts)-3:]
-		}
-		fields.CallerInfo = fmt.Sprintf("%s:%d", strings.Join(fileParts, "/"), line)
+	l.mutex.Lock()
+	sampler := l.sampler
+	l.mutex.Unlock()
+	if sampler != nil && !sampler.Sample(level, msg) {
+		l.samplerDropped.Add(1)
+		return
+	}
+
+	l.mutex.Lock()
+	reportCaller := l.reportCaller
+	l.mutex.Unlock()
+	if fields.CallerInfo == "" && reportCaller {
+		fields.CallerInfo = l.captureCaller(fields.CallerSkip)
 	}
 
 	msg = strings.ReplaceAll(msg, "\n", " ")
@@ -109,16 +187,30 @@ func (l *Logger) Log(level LogLevel, msg string, fields LogFields) {
 	}
 
 	l.mutex.Lock()
-	defer l.mutex.Unlock()
-	fmt.Fprintln(l.out, output)
-}
+	a := l.async
+	l.mutex.Unlock()
+
+	if a != nil {
+		l.writeAsync(a, output)
+	} else {
+		l.mutex.Lock()
+		fmt.Fprintln(l.out, output)
+		l.mutex.Unlock()
+	}
 
-func (l *Logger) LogCtx(ctx context.Context, level LogLevel, msg string, fields LogFields) {
-	l.Log(level, msg, fields)
+	ts := time.Now()
+	if !fields.Time.IsZero() {
+		ts = fields.Time
+	}
+	l.fireHooks(level, msg, fields, ts)
 }
 
 func defaultFormatter(level LogLevel, message string, fields LogFields) string {
-	timestamp := time.Now().Format(time.RFC3339)
+	ts := time.Now()
+	if !fields.Time.IsZero() {
+		ts = fields.Time
+	}
+	timestamp := ts.Format(time.RFC3339)
 	var levelStr string
 	var levelColor *color.Color
 
@@ -157,6 +249,16 @@ func defaultFormatter(level LogLevel, message string, fields LogFields) string {
 	if fields.Latency != "" {
 		components = append(components, color.New(color.FgHiMagenta).Sprint(fields.Latency))
 	}
+	if len(fields.Extra) > 0 {
+		keys := make([]string, 0, len(fields.Extra))
+		for k := range fields.Extra {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			components = append(components, color.New(color.FgHiMagenta).Sprintf("%s=%v", k, fields.Extra[k]))
+		}
+	}
 
 	components = append(components,
 		color.New(color.FgHiWhite).Sprint("-"),
@@ -170,11 +272,15 @@ func defaultFormatter(level LogLevel, message string, fields LogFields) string {
 }
 
 func JSONFormatter(level LogLevel, message string, fields LogFields) string {
+	ts := time.Now()
+	if !fields.Time.IsZero() {
+		ts = fields.Time
+	}
 	entry := map[string]any{
-		"timestamp": time.Now().Format(time.RFC3339),
+		"timestamp": ts.Format(time.RFC3339),
 		"level":     level.String(),
 		"message":   message,
-		"fields":    fields,
+		"fields":    fields.toMap(),
 	}
 	data, _ := json.Marshal(entry)
 	return string(data)
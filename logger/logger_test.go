@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"io"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestLogLevelFiltering(t *testing.T) {
@@ -96,11 +99,149 @@ func TestLoggerConcurrency(t *testing.T) {
 	}
 }
 
+func TestEntryWithFields(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewLogger("entry")
+	logger.SetOutput(buf)
+	logger.SetColor(false)
+	logger.SetFormatter(JSONFormatter)
+
+	logger.WithField("userID", "abc123").WithFields(map[string]any{"retries": 3}).Info("entry log")
+
+	var logEntry map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &logEntry); err != nil {
+		t.Fatalf("failed to parse JSON log: %v", err)
+	}
+
+	fields, ok := logEntry["fields"].(map[string]interface{})
+	if !ok {
+		t.Fatal("JSON fields not found or not a map")
+	}
+	if fields["userID"] != "abc123" || fields["retries"] != float64(3) {
+		t.Errorf("entry fields not merged correctly: %v", fields)
+	}
+}
+
+func TestEntryWithTime(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewLogger("entry-time")
+	logger.SetOutput(buf)
+	logger.SetColor(false)
+	logger.SetFormatter(JSONFormatter)
+
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	logger.WithField("x", 1).WithTime(ts).Info("entry with time")
+
+	var logEntry map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &logEntry); err != nil {
+		t.Fatalf("failed to parse JSON log: %v", err)
+	}
+	if logEntry["timestamp"] != ts.Format(time.RFC3339) {
+		t.Errorf("expected overridden timestamp %s, got %v", ts.Format(time.RFC3339), logEntry["timestamp"])
+	}
+}
+
+type testHook struct {
+	levels []LogLevel
+	fired  []string
+	err    error
+}
+
+func (h *testHook) Levels() []LogLevel { return h.levels }
+func (h *testHook) Fire(level LogLevel, msg string, fields LogFields, ts time.Time) error {
+	h.fired = append(h.fired, msg)
+	return h.err
+}
+
+func TestLoggerWithContextBuiltinExtractors(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewLogger("ctx-builtin")
+	logger.SetOutput(buf)
+	logger.SetColor(false)
+	logger.RegisterContextExtractor(RequestIDExtractor)
+
+	ctx := context.WithValue(context.Background(), contextKeyRequestID, "req-42")
+	logger.LogCtx(ctx, LevelInfo, "request handled", LogFields{})
+
+	out := buf.String()
+	if !strings.Contains(out, "request_id=req-42") {
+		t.Errorf("expected request_id=req-42 in output, got: %s", out)
+	}
+}
+
+func TestLoggerHooks(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewLogger("hooks")
+	logger.SetOutput(buf)
+	logger.SetColor(false)
+
+	errHook := &testHook{levels: []LogLevel{LevelError}}
+	infoHook := &testHook{levels: []LogLevel{LevelInfo}}
+	logger.AddHook(errHook)
+	logger.AddHook(infoHook)
+
+	logger.Info("info message", LogFields{})
+	logger.Error("error message", LogFields{})
+
+	if len(errHook.fired) != 1 || errHook.fired[0] != "error message" {
+		t.Errorf("expected error hook to fire once with the error message, got %v", errHook.fired)
+	}
+	if len(infoHook.fired) != 1 || infoHook.fired[0] != "info message" {
+		t.Errorf("expected info hook to fire once with the info message, got %v", infoHook.fired)
+	}
+
+	logger.ClearHooks()
+	logger.Info("after clear", LogFields{})
+	if len(infoHook.fired) != 1 {
+		t.Error("expected no hooks to fire after ClearHooks")
+	}
+}
+
+func TestLoggerHookErrorHandler(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewLogger("hook-errors")
+	logger.SetOutput(buf)
+	logger.SetColor(false)
+
+	reportedErr := errors.New("sink unavailable")
+	logger.AddHook(&testHook{levels: []LogLevel{LevelInfo}, err: reportedErr})
+
+	var gotErr error
+	logger.SetErrorHandler(func(err error) { gotErr = err })
+	logger.Info("trigger hook failure", LogFields{})
+
+	if gotErr != reportedErr {
+		t.Errorf("expected error handler to receive %v, got %v", reportedErr, gotErr)
+	}
+}
+
+func TestLoggerHookErrorHandlerConcurrentAccess(t *testing.T) {
+	logger := NewLogger("hook-errors-concurrent")
+	logger.SetOutput(io.Discard)
+	logger.SetColor(false)
+	logger.AddHook(&testHook{levels: []LogLevel{LevelInfo}, err: errors.New("sink unavailable")})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			logger.Info("concurrent with SetErrorHandler", LogFields{})
+		}
+	}()
+
+	for i := 0; i < 100; i++ {
+		logger.SetErrorHandler(func(error) {})
+	}
+	<-done
+}
+
 func TestLoggerWithContext(t *testing.T) {
 	buf := &bytes.Buffer{}
 	logger := NewLogger("ctx")
 	logger.SetOutput(buf)
+	logger.SetColor(false)
 	logger.SetLevel(LevelInfo)
+	logger.RegisterContextKey("userID", "userID")
 
 	ctx := context.WithValue(context.Background(), "userID", "abc123")
 	logger.LogCtx(ctx, LevelInfo, "log with context", LogFields{Path: "/ctx"})
@@ -109,4 +250,7 @@ func TestLoggerWithContext(t *testing.T) {
 	if !strings.Contains(out, "log with context") {
 		t.Error("context-based logging failed")
 	}
+	if !strings.Contains(out, "userID=abc123") {
+		t.Errorf("expected context field userID=abc123 in output, got: %s", out)
+	}
 }
@@ -0,0 +1,139 @@
+package logger
+
+import (
+	"hash/fnv"
+	"sync"
+	"time"
+)
+
+// Sampler decides whether a record should actually be logged, letting
+// high-volume services avoid drowning their output. Log calls Sample
+// after the level filter and before formatting.
+type Sampler interface {
+	Sample(level LogLevel, msg string) bool
+}
+
+// SetSampler installs s. A nil sampler (the default) samples
+// everything.
+func (l *Logger) SetSampler(s Sampler) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.sampler = s
+}
+
+// RateSampler is a token-bucket Sampler: up to burst records pass
+// instantly, and the bucket refills at perSecond tokens per second.
+type RateSampler struct {
+	perSecond float64
+	maxTokens float64
+
+	mu         sync.Mutex
+	tokens     float64
+	lastRefill time.Time
+}
+
+// NewRateSampler returns a RateSampler that allows perSecond records
+// per second on average, with bursts up to burst.
+func NewRateSampler(perSecond, burst int) *RateSampler {
+	return &RateSampler{
+		perSecond:  float64(perSecond),
+		maxTokens:  float64(burst),
+		tokens:     float64(burst),
+		lastRefill: time.Now(),
+	}
+}
+
+func (s *RateSampler) Sample(level LogLevel, msg string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	elapsed := now.Sub(s.lastRefill).Seconds()
+	s.lastRefill = now
+	s.tokens = min(s.maxTokens, s.tokens+elapsed*s.perSecond)
+
+	if s.tokens < 1 {
+		return false
+	}
+	s.tokens--
+	return true
+}
+
+type burstWindow struct {
+	count uint64
+	ends  time.Time
+}
+
+// BurstSampler lets the first `first` occurrences of a given
+// (level, message) pair through within a window, then only every
+// `thereafter`th occurrence after that, resetting once window has
+// elapsed since the pair was first seen.
+type BurstSampler struct {
+	first      int
+	thereafter int
+	window     time.Duration
+
+	mu        sync.Mutex
+	state     map[uint64]*burstWindow
+	lastSweep time.Time
+}
+
+// NewBurstSampler returns a BurstSampler with the given first/
+// thereafter/window settings.
+func NewBurstSampler(first, thereafter int, window time.Duration) *BurstSampler {
+	return &BurstSampler{
+		first:      first,
+		thereafter: thereafter,
+		window:     window,
+		state:      make(map[uint64]*burstWindow),
+	}
+}
+
+func (s *BurstSampler) Sample(level LogLevel, msg string) bool {
+	key := burstKey(level, msg)
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweepLocked(now)
+
+	w, ok := s.state[key]
+	if !ok || now.After(w.ends) {
+		w = &burstWindow{ends: now.Add(s.window)}
+		s.state[key] = w
+	}
+
+	w.count++
+	if w.count <= uint64(s.first) {
+		return true
+	}
+	if s.thereafter <= 0 {
+		return false
+	}
+	return (w.count-uint64(s.first))%uint64(s.thereafter) == 0
+}
+
+// sweepLocked deletes state entries whose window closed long enough
+// ago that the pair is no longer in burst. It is throttled to run at
+// most once per window so normal Sample calls stay O(1); callers must
+// hold s.mu.
+func (s *BurstSampler) sweepLocked(now time.Time) {
+	if now.Sub(s.lastSweep) < s.window {
+		return
+	}
+	s.lastSweep = now
+
+	for key, w := range s.state {
+		if now.After(w.ends) {
+			delete(s.state, key)
+		}
+	}
+}
+
+func burstKey(level LogLevel, msg string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte{byte(level)})
+	h.Write([]byte(msg))
+	return h.Sum64()
+}
@@ -0,0 +1,85 @@
+package logger
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestBurstSamplerCollapsesRepeats(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewLogger("sampler")
+	logger.SetOutput(buf)
+	logger.SetColor(false)
+	logger.SetSampler(NewBurstSampler(3, 5, time.Minute))
+
+	for range 10000 {
+		logger.Error("disk full", LogFields{})
+	}
+
+	// First 3 pass, then every 5th of the remaining 9997: 9997/5 = 1999
+	// (integer division), for 2002 total lines logged.
+	want := 3 + 9997/5
+	got := countLines(buf.String())
+	if got != want {
+		t.Errorf("expected %d sampled lines, got %d", want, got)
+	}
+
+	if dropped := logger.Stats().DroppedBySampler; dropped != 10000-uint64(want) {
+		t.Errorf("expected %d records dropped by sampler, got %d", 10000-want, dropped)
+	}
+}
+
+func TestRateSamplerLimitsBurst(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewLogger("rate-sampler")
+	logger.SetOutput(buf)
+	logger.SetColor(false)
+	logger.SetSampler(NewRateSampler(1, 5))
+
+	for range 20 {
+		logger.Info("flood", LogFields{})
+	}
+
+	got := countLines(buf.String())
+	if got != 5 {
+		t.Errorf("expected only the initial burst of 5 to pass, got %d", got)
+	}
+}
+
+func TestBurstSamplerSweepsStaleWindows(t *testing.T) {
+	s := NewBurstSampler(1, 1, 10*time.Millisecond)
+
+	for i := range 50 {
+		s.Sample(LevelError, fmt.Sprintf("distinct message %d", i))
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	// A Sample call for a fresh message triggers a sweep (throttled to
+	// once per window, which has now elapsed), clearing out the stale
+	// entries from the first batch.
+	s.Sample(LevelError, "sweep trigger")
+
+	s.mu.Lock()
+	size := len(s.state)
+	s.mu.Unlock()
+
+	if size > 1 {
+		t.Errorf("expected stale burst windows to be swept, got %d entries still tracked", size)
+	}
+}
+
+func countLines(s string) int {
+	if s == "" {
+		return 0
+	}
+	count := 0
+	for _, r := range s {
+		if r == '\n' {
+			count++
+		}
+	}
+	return count
+}
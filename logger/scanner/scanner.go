@@ -0,0 +1,234 @@
+// Package scanner parses newline-delimited log streams (JSON, as
+// produced by logger.JSONFormatter, or logfmt key=value pairs) and
+// re-emits them in go-logger's colored, human-friendly style. It lets
+// JSON/logfmt logs from another process be piped through for a
+// pretty-printed view, e.g. `myservice | go-logger-pretty`.
+package scanner
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+
+	"github.com/KOFI-GYIMAH/go-logger/logger"
+)
+
+// ScanOptions controls how Scan parses and re-emits log lines.
+type ScanOptions struct {
+	// TimeFormat is used to render each record's timestamp. Defaults to
+	// time.RFC3339 when empty.
+	TimeFormat string
+	// LevelColors overrides the color used for a given level; levels
+	// absent from the map fall back to go-logger's default scheme.
+	LevelColors map[logger.LogLevel]*color.Color
+	// AllowKeys, if non-empty, restricts emitted fields to this set.
+	AllowKeys []string
+	// DenyKeys drops these fields even if AllowKeys would include them.
+	DenyKeys []string
+	// SkipUnknown drops lines that are neither valid JSON nor logfmt
+	// instead of passing them through verbatim.
+	SkipUnknown bool
+}
+
+// Scan reads newline-delimited records from in and writes their
+// colored, pretty-printed form to out until in is exhausted or
+// returns an error.
+func Scan(in io.Reader, out io.Writer, opts ScanOptions) error {
+	s := bufio.NewScanner(in)
+	s.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for s.Scan() {
+		line := s.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		rec, ok := parseLine(line)
+		if !ok {
+			if opts.SkipUnknown {
+				continue
+			}
+			fmt.Fprintln(out, line)
+			continue
+		}
+
+		fmt.Fprintln(out, render(rec, opts))
+	}
+	return s.Err()
+}
+
+type record struct {
+	level   logger.LogLevel
+	message string
+	fields  map[string]any
+	time    time.Time
+}
+
+func parseLine(line string) (record, bool) {
+	if rec, ok := parseJSON(line); ok {
+		return rec, true
+	}
+	return parseLogfmt(line)
+}
+
+func parseJSON(line string) (record, bool) {
+	var raw map[string]any
+	if err := json.Unmarshal([]byte(line), &raw); err != nil {
+		return record{}, false
+	}
+
+	rec := record{fields: map[string]any{}}
+	if msg, ok := raw["message"].(string); ok {
+		rec.message = msg
+	}
+	if lvl, ok := raw["level"].(string); ok {
+		rec.level = parseLevel(lvl)
+	}
+	if ts, ok := raw["timestamp"].(string); ok {
+		if t, err := time.Parse(time.RFC3339, ts); err == nil {
+			rec.time = t
+		}
+	}
+	if f, ok := raw["fields"].(map[string]any); ok {
+		rec.fields = f
+	}
+	return rec, true
+}
+
+func parseLogfmt(line string) (record, bool) {
+	fields := map[string]any{}
+	for _, tok := range strings.Fields(line) {
+		kv := strings.SplitN(tok, "=", 2)
+		if len(kv) != 2 {
+			return record{}, false
+		}
+		fields[kv[0]] = strings.Trim(kv[1], `"`)
+	}
+	if len(fields) == 0 {
+		return record{}, false
+	}
+
+	rec := record{fields: fields}
+	if lvl, ok := fields["level"].(string); ok {
+		rec.level = parseLevel(lvl)
+		delete(fields, "level")
+	}
+	for _, msgKey := range []string{"msg", "message"} {
+		if msg, ok := fields[msgKey].(string); ok {
+			rec.message = msg
+			delete(fields, msgKey)
+			break
+		}
+	}
+	for _, timeKey := range []string{"time", "timestamp"} {
+		if ts, ok := fields[timeKey].(string); ok {
+			if t, err := time.Parse(time.RFC3339, ts); err == nil {
+				rec.time = t
+			}
+			delete(fields, timeKey)
+			break
+		}
+	}
+	return rec, true
+}
+
+func parseLevel(s string) logger.LogLevel {
+	switch strings.ToLower(s) {
+	case "debug":
+		return logger.LevelDebug
+	case "warn", "warning":
+		return logger.LevelWarn
+	case "error":
+		return logger.LevelError
+	case "fatal":
+		return logger.LevelFatal
+	default:
+		return logger.LevelInfo
+	}
+}
+
+func render(rec record, opts ScanOptions) string {
+	timeFormat := opts.TimeFormat
+	if timeFormat == "" {
+		timeFormat = time.RFC3339
+	}
+	ts := rec.time
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+
+	components := []string{
+		levelColor(rec.level, opts.LevelColors).Sprintf("[%s]", strings.ToUpper(rec.level.String())),
+		color.New(color.FgHiWhite).Sprint(ts.Format(timeFormat)),
+		color.New(color.FgCyan).Sprint(rec.message),
+	}
+
+	fields := filterKeys(rec.fields, opts.AllowKeys, opts.DenyKeys)
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		components = append(components, color.New(color.FgHiMagenta).Sprintf("%s=%v", k, fields[k]))
+	}
+
+	return strings.Join(components, " ")
+}
+
+func levelColor(level logger.LogLevel, overrides map[logger.LogLevel]*color.Color) *color.Color {
+	if c, ok := overrides[level]; ok && c != nil {
+		return c
+	}
+	switch level {
+	case logger.LevelDebug:
+		return color.New(color.FgHiCyan)
+	case logger.LevelInfo:
+		return color.New(color.FgHiGreen)
+	case logger.LevelWarn:
+		return color.New(color.FgHiYellow)
+	case logger.LevelError:
+		return color.New(color.FgHiRed)
+	case logger.LevelFatal:
+		return color.New(color.FgHiMagenta)
+	default:
+		return color.New(color.FgWhite)
+	}
+}
+
+func filterKeys(fields map[string]any, allow, deny []string) map[string]any {
+	if len(allow) == 0 && len(deny) == 0 {
+		return fields
+	}
+
+	allowSet := toSet(allow)
+	denySet := toSet(deny)
+
+	out := make(map[string]any, len(fields))
+	for k, v := range fields {
+		if len(allowSet) > 0 {
+			if _, ok := allowSet[k]; !ok {
+				continue
+			}
+		}
+		if _, ok := denySet[k]; ok {
+			continue
+		}
+		out[k] = v
+	}
+	return out
+}
+
+func toSet(keys []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(keys))
+	for _, k := range keys {
+		set[k] = struct{}{}
+	}
+	return set
+}
@@ -0,0 +1,62 @@
+package scanner
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestScanJSON(t *testing.T) {
+	in := strings.NewReader(`{"timestamp":"2024-01-02T03:04:05Z","level":"error","message":"boom","fields":{"status":"500"}}` + "\n")
+	out := &bytes.Buffer{}
+
+	if err := Scan(in, out, ScanOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := out.String()
+	if !strings.Contains(got, "boom") || !strings.Contains(got, "ERROR") || !strings.Contains(got, "status=500") {
+		t.Errorf("unexpected rendered line: %s", got)
+	}
+}
+
+func TestScanLogfmt(t *testing.T) {
+	in := strings.NewReader(`level=warn msg=slow path=/api status=200` + "\n")
+	out := &bytes.Buffer{}
+
+	if err := Scan(in, out, ScanOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := out.String()
+	if !strings.Contains(got, "slow") || !strings.Contains(got, "WARN") || !strings.Contains(got, "path=/api") {
+		t.Errorf("unexpected rendered line: %s", got)
+	}
+}
+
+func TestScanAllowDenyKeys(t *testing.T) {
+	in := strings.NewReader(`level=info msg=ok a=1 b=2` + "\n")
+	out := &bytes.Buffer{}
+
+	if err := Scan(in, out, ScanOptions{AllowKeys: []string{"a", "b"}, DenyKeys: []string{"b"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := out.String()
+	if !strings.Contains(got, "a=1") || strings.Contains(got, "b=2") {
+		t.Errorf("allow/deny filtering failed: %s", got)
+	}
+}
+
+func TestScanSkipUnknown(t *testing.T) {
+	in := strings.NewReader("not a log line\n")
+	out := &bytes.Buffer{}
+
+	if err := Scan(in, out, ScanOptions{SkipUnknown: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if out.Len() != 0 {
+		t.Errorf("expected unknown line to be skipped, got: %s", out.String())
+	}
+}